@@ -0,0 +1,58 @@
+// Command ifacegen generates a mockable interface from a concrete struct
+// type's exported method set.
+//
+//	ifacegen -struct UserService -pkg sample -o user_iface.go -name UserServicer
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/elev8tion/c-ext/tests/fixtures/gen"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "ifacegen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("ifacegen", flag.ContinueOnError)
+	structName := fs.String("struct", "", "name of the concrete struct type to generate an interface for")
+	pkgName := fs.String("pkg", "", "package name of the input files")
+	outFile := fs.String("o", "", "output file path")
+	ifaceName := fs.String("name", "", "name of the generated interface")
+	recvPointer := fs.Bool("recv-pointer", true, "include pointer-receiver methods; pass -recv-pointer=false to restrict output to value-receiver methods")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	files := fs.Args()
+	if len(files) == 0 {
+		matches, err := filepath.Glob("*.go")
+		if err != nil || len(matches) == 0 {
+			return fmt.Errorf("no input files given and none found via *.go in the current directory")
+		}
+		files = matches
+	}
+	if *structName == "" || *pkgName == "" || *outFile == "" || *ifaceName == "" {
+		return fmt.Errorf("-struct, -pkg, -o, and -name are all required")
+	}
+
+	src, err := gen.Generate(gen.Options{
+		Files:         files,
+		StructName:    *structName,
+		PkgName:       *pkgName,
+		InterfaceName: *ifaceName,
+		RecvPointer:   *recvPointer,
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(*outFile, src, 0o644)
+}
@@ -0,0 +1,87 @@
+package sample
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidEmail reports that an email address failed validation.
+type ErrInvalidEmail struct {
+	Email string
+}
+
+func (e *ErrInvalidEmail) Error() string {
+	return fmt.Sprintf("invalid email: %q", e.Email)
+}
+
+// IsErrInvalidEmail reports whether err is, or wraps, an *ErrInvalidEmail.
+func IsErrInvalidEmail(err error) bool {
+	var target *ErrInvalidEmail
+	return errors.As(err, &target)
+}
+
+// ErrInvalidName reports that a name failed validation.
+type ErrInvalidName struct {
+	Name string
+}
+
+func (e *ErrInvalidName) Error() string {
+	return fmt.Sprintf("invalid name: %q", e.Name)
+}
+
+// IsErrInvalidName reports whether err is, or wraps, an *ErrInvalidName.
+func IsErrInvalidName(err error) bool {
+	var target *ErrInvalidName
+	return errors.As(err, &target)
+}
+
+// ErrUnderAge reports that Age fell below Min.
+type ErrUnderAge struct {
+	Age int
+	Min int
+}
+
+func (e *ErrUnderAge) Error() string {
+	return fmt.Sprintf("age %d is under the minimum of %d", e.Age, e.Min)
+}
+
+// IsErrUnderAge reports whether err is, or wraps, an *ErrUnderAge.
+func IsErrUnderAge(err error) bool {
+	var target *ErrUnderAge
+	return errors.As(err, &target)
+}
+
+// ErrOverAge reports that Age exceeded Max.
+type ErrOverAge struct {
+	Age int
+	Max int
+}
+
+func (e *ErrOverAge) Error() string {
+	return fmt.Sprintf("age %d is over the maximum of %d", e.Age, e.Max)
+}
+
+// IsErrOverAge reports whether err is, or wraps, an *ErrOverAge.
+func IsErrOverAge(err error) bool {
+	var target *ErrOverAge
+	return errors.As(err, &target)
+}
+
+// Errors aggregates multiple validation failures into a single error while
+// still letting errors.As reach an individual cause.
+type Errors []error
+
+func (e Errors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap exposes the individual causes so errors.Is and errors.As can walk
+// into any one of them.
+func (e Errors) Unwrap() []error {
+	return e
+}
@@ -0,0 +1,96 @@
+// Package format provides locale-aware presentation helpers for the sample
+// package: ages, currency amounts, and gender codes.
+package format
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// LocaleTable holds the locale-specific pieces needed to render an age or a
+// currency amount. Third-party locales can be added with RegisterLocale
+// without forking this package.
+type LocaleTable struct {
+	// AgeSuffix is appended after the number, e.g. "years old" or "años".
+	AgeSuffix string
+	// CurrencySymbol is the symbol or code prefixed to the amount, e.g. "$"
+	// or "Rp ".
+	CurrencySymbol string
+	// ThousandsSep separates groups of three digits, e.g. "," or ".".
+	ThousandsSep string
+}
+
+var locales = map[string]LocaleTable{
+	"en-US": {AgeSuffix: "years old", CurrencySymbol: "$", ThousandsSep: ","},
+	"id-ID": {AgeSuffix: "tahun", CurrencySymbol: "Rp ", ThousandsSep: "."},
+	"es-ES": {AgeSuffix: "años", CurrencySymbol: "€", ThousandsSep: "."},
+}
+
+// RegisterLocale adds or overrides the table for tag (a BCP 47-ish locale
+// tag such as "fr-FR"), so third-party locales can be added without forking
+// this package.
+func RegisterLocale(tag string, table LocaleTable) {
+	locales[tag] = table
+}
+
+func lookup(locale string) (LocaleTable, bool) {
+	table, ok := locales[locale]
+	return table, ok
+}
+
+// FormatAge renders age using locale's AgeSuffix, e.g. "3 years old",
+// "3 años", or "3 tahun". Unknown locales fall back to en-US.
+func FormatAge(age int, locale string) string {
+	table, ok := lookup(locale)
+	if !ok {
+		table = locales["en-US"]
+	}
+	return fmt.Sprintf("%d %s", age, table.AgeSuffix)
+}
+
+// FormatCurrency renders amount (in whole currency units) grouped by
+// locale's ThousandsSep and prefixed with its CurrencySymbol, e.g.
+// "Rp 3.000" for id-ID or "$3,000" for en-US. Unknown locales fall back to
+// en-US.
+func FormatCurrency(amount int64, locale string) string {
+	table, ok := lookup(locale)
+	if !ok {
+		table = locales["en-US"]
+	}
+	return table.CurrencySymbol + groupThousands(amount, table.ThousandsSep)
+}
+
+func groupThousands(amount int64, sep string) string {
+	neg := amount < 0
+	if neg {
+		amount = -amount
+	}
+	digits := strconv.FormatInt(amount, 10)
+
+	var groups []string
+	for len(digits) > 3 {
+		groups = append([]string{digits[len(digits)-3:]}, groups...)
+		digits = digits[:len(digits)-3]
+	}
+	groups = append([]string{digits}, groups...)
+
+	s := strings.Join(groups, sep)
+	if neg {
+		s = "-" + s
+	}
+	return s
+}
+
+// FormatGender maps a gender code to its short label: 1 -> "M", 2 -> "F",
+// and anything else -> "U".
+func FormatGender(code int) string {
+	switch code {
+	case 1:
+		return "M"
+	case 2:
+		return "F"
+	default:
+		return "U"
+	}
+}
@@ -0,0 +1,67 @@
+package format
+
+import "testing"
+
+func TestFormatAge(t *testing.T) {
+	tests := []struct {
+		age    int
+		locale string
+		want   string
+	}{
+		{3, "en-US", "3 years old"},
+		{3, "es-ES", "3 años"},
+		{3, "id-ID", "3 tahun"},
+		{3, "unknown-locale", "3 years old"},
+	}
+	for _, tt := range tests {
+		if got := FormatAge(tt.age, tt.locale); got != tt.want {
+			t.Errorf("FormatAge(%d, %q) = %q, want %q", tt.age, tt.locale, got, tt.want)
+		}
+	}
+}
+
+func TestFormatCurrency(t *testing.T) {
+	tests := []struct {
+		amount int64
+		locale string
+		want   string
+	}{
+		{3000, "en-US", "$3,000"},
+		{3000, "id-ID", "Rp 3.000"},
+		{1234567, "en-US", "$1,234,567"},
+		{0, "en-US", "$0"},
+		{-3000, "en-US", "$-3,000"},
+	}
+	for _, tt := range tests {
+		if got := FormatCurrency(tt.amount, tt.locale); got != tt.want {
+			t.Errorf("FormatCurrency(%d, %q) = %q, want %q", tt.amount, tt.locale, got, tt.want)
+		}
+	}
+}
+
+func TestFormatGender(t *testing.T) {
+	tests := []struct {
+		code int
+		want string
+	}{
+		{1, "M"},
+		{2, "F"},
+		{0, "U"},
+		{99, "U"},
+	}
+	for _, tt := range tests {
+		if got := FormatGender(tt.code); got != tt.want {
+			t.Errorf("FormatGender(%d) = %q, want %q", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	RegisterLocale("fr-FR", LocaleTable{AgeSuffix: "ans", CurrencySymbol: "€", ThousandsSep: " "})
+	if got, want := FormatAge(3, "fr-FR"), "3 ans"; got != want {
+		t.Errorf("FormatAge(3, fr-FR) = %q, want %q", got, want)
+	}
+	if got, want := FormatCurrency(3000, "fr-FR"), "€3 000"; got != want {
+		t.Errorf("FormatCurrency(3000, fr-FR) = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,204 @@
+// Package gen generates mockable interfaces from concrete struct types by
+// reading their exported method set with go/parser and go/types. It backs
+// the cmd/ifacegen tool.
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"sort"
+)
+
+// Options controls which interface is generated and how.
+type Options struct {
+	// Files is the set of Go source files to parse, all belonging to the
+	// same package.
+	Files []string
+	// StructName is the concrete type to generate an interface for, e.g.
+	// "UserService".
+	StructName string
+	// PkgName is the package name written into the generated file.
+	PkgName string
+	// InterfaceName is the name of the generated interface, e.g.
+	// "UserServicer".
+	InterfaceName string
+	// RecvPointer includes pointer-receiver methods when true. Value-receiver
+	// methods are always included, since they're callable on both.
+	RecvPointer bool
+}
+
+// Generate parses opts.Files, locates opts.StructName, and returns the
+// formatted source of a file defining opts.InterfaceName with the struct's
+// exported methods: its value-receiver methods, plus its pointer-receiver
+// methods when opts.RecvPointer is true. It returns an error if the struct is
+// not found among the parsed files.
+func Generate(opts Options) ([]byte, error) {
+	fset := token.NewFileSet()
+	var astFiles []*ast.File
+	for _, f := range opts.Files {
+		file, err := parser.ParseFile(fset, f, nil, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("gen: parsing %s: %w", f, err)
+		}
+		astFiles = append(astFiles, file)
+	}
+
+	conf := types.Config{Importer: importer.Default(), Error: func(error) {}}
+	pkg, _ := conf.Check(opts.PkgName, fset, astFiles, nil)
+	if pkg == nil {
+		return nil, fmt.Errorf("gen: failed to type-check %v", opts.Files)
+	}
+	// Type errors are ignored here: they're common in partial type-checks
+	// (e.g. unresolved external imports), and we only need enough
+	// information to resolve the target struct's method signatures.
+
+	obj := pkg.Scope().Lookup(opts.StructName)
+	if obj == nil {
+		return nil, fmt.Errorf("gen: struct %q not found in %v", opts.StructName, opts.Files)
+	}
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return nil, fmt.Errorf("gen: %q is not a named type", opts.StructName)
+	}
+
+	methods, imports, err := collectMethods(pkg, named, opts.RecvPointer)
+	if err != nil {
+		return nil, err
+	}
+
+	docs := collectDocs(astFiles, opts.StructName)
+
+	return render(opts, methods, imports, docs)
+}
+
+type method struct {
+	Name string
+	Sig  string
+}
+
+// collectMethods returns the struct's exported methods, rendered with
+// signatures qualified by package name, plus the sorted list of import
+// paths those signatures reference. Types from pkg itself (the package
+// being generated into) are left unqualified.
+//
+// recvPointer selects the pointer method set, which includes both pointer-
+// and value-receiver methods; without it, only methods promoted on the
+// value itself are collected.
+func collectMethods(pkg *types.Package, named *types.Named, recvPointer bool) ([]method, []string, error) {
+	var methods []method
+	mset := types.NewMethodSet(named)
+	if recvPointer {
+		mset = types.NewMethodSet(types.NewPointer(named))
+	}
+
+	imports := map[string]struct{}{}
+	qualifier := func(p *types.Package) string {
+		if p == nil || p == pkg {
+			return ""
+		}
+		imports[p.Path()] = struct{}{}
+		return p.Name()
+	}
+
+	for i := 0; i < mset.Len(); i++ {
+		sel := mset.At(i)
+		if !sel.Obj().Exported() {
+			continue
+		}
+		sig, ok := sel.Obj().Type().(*types.Signature)
+		if !ok {
+			continue
+		}
+		methods = append(methods, method{
+			Name: sel.Obj().Name(),
+			Sig:  types.TypeString(sig, qualifier),
+		})
+	}
+
+	importPaths := make([]string, 0, len(imports))
+	for path := range imports {
+		importPaths = append(importPaths, path)
+	}
+	sort.Strings(importPaths)
+
+	return methods, importPaths, nil
+}
+
+func collectDocs(files []*ast.File, structName string) map[string]string {
+	docs := map[string]string{}
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || fn.Doc == nil {
+				continue
+			}
+			if recvTypeName(fn.Recv) != structName {
+				continue
+			}
+			docs[fn.Name.Name] = fn.Doc.Text()
+		}
+	}
+	return docs
+}
+
+func recvTypeName(recv *ast.FieldList) string {
+	if len(recv.List) == 0 {
+		return ""
+	}
+	expr := recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+	return ident.Name
+}
+
+func render(opts Options, methods []method, imports []string, docs map[string]string) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by ifacegen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", opts.PkgName)
+	if len(imports) > 0 {
+		fmt.Fprintf(&buf, "import (\n")
+		for _, path := range imports {
+			fmt.Fprintf(&buf, "\t%q\n", path)
+		}
+		fmt.Fprintf(&buf, ")\n\n")
+	}
+	fmt.Fprintf(&buf, "// %s is the generated interface over %s.\n", opts.InterfaceName, opts.StructName)
+	fmt.Fprintf(&buf, "type %s interface {\n", opts.InterfaceName)
+	for _, m := range methods {
+		if doc, ok := docs[m.Name]; ok && doc != "" {
+			for _, line := range splitLines(doc) {
+				fmt.Fprintf(&buf, "\t// %s\n", line)
+			}
+		}
+		fmt.Fprintf(&buf, "\t%s%s\n", m.Name, m.Sig[len("func"):])
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	return format.Source(buf.Bytes())
+}
+
+func splitLines(s string) []string {
+	var lines []string
+	start := 0
+	for i, r := range s {
+		if r == '\n' {
+			lines = append(lines, s[start:i])
+			start = i + 1
+		}
+	}
+	if start < len(s) {
+		lines = append(lines, s[start:])
+	}
+	return lines
+}
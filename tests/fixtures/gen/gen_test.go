@@ -0,0 +1,166 @@
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeTempFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+const sampleSrc = `package sample
+
+import "fmt"
+
+type Options struct {
+	Locale string
+}
+
+type UserService struct {
+	Name string
+}
+
+func (u *UserService) String() string {
+	return fmt.Sprintf("%s", u.Name)
+}
+
+// WithOptions formats u using opts.
+func (u *UserService) WithOptions(opts Options) string {
+	return u.String() + opts.Locale
+}
+`
+
+func TestGenerateRecvPointerIncludesPointerMethods(t *testing.T) {
+	dir := t.TempDir()
+	file := writeTempFile(t, dir, "sample.go", sampleSrc)
+
+	src, err := Generate(Options{
+		Files:         []string{file},
+		StructName:    "UserService",
+		PkgName:       "sample",
+		InterfaceName: "UserServicer",
+		RecvPointer:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := string(src)
+	if !strings.Contains(out, "String() string") {
+		t.Errorf("expected pointer-receiver method String with RecvPointer=true, got:\n%s", out)
+	}
+	if !strings.Contains(out, "WithOptions(opts Options) string") {
+		t.Errorf("expected pointer-receiver method WithOptions with RecvPointer=true, got:\n%s", out)
+	}
+}
+
+func TestGenerateSamePackageTypeUnqualified(t *testing.T) {
+	dir := t.TempDir()
+	file := writeTempFile(t, dir, "sample.go", sampleSrc)
+
+	src, err := Generate(Options{
+		Files:         []string{file},
+		StructName:    "UserService",
+		PkgName:       "sample",
+		InterfaceName: "UserServicer",
+		RecvPointer:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := string(src)
+	if strings.Contains(out, "sample.Options") {
+		t.Errorf("same-package type Options should be unqualified, got:\n%s", out)
+	}
+	if !strings.Contains(out, "opts Options") {
+		t.Errorf("expected unqualified Options parameter, got:\n%s", out)
+	}
+}
+
+func TestGenerateWithoutRecvPointerRestrictsToValueMethods(t *testing.T) {
+	dir := t.TempDir()
+	file := writeTempFile(t, dir, "sample.go", sampleSrc)
+
+	src, err := Generate(Options{
+		Files:         []string{file},
+		StructName:    "UserService",
+		PkgName:       "sample",
+		InterfaceName: "UserServicer",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := string(src)
+	if strings.Contains(out, "String()") || strings.Contains(out, "WithOptions(") {
+		t.Errorf("RecvPointer=false should exclude pointer-receiver-only methods, got:\n%s", out)
+	}
+}
+
+func TestGenerateStructNotFound(t *testing.T) {
+	dir := t.TempDir()
+	file := writeTempFile(t, dir, "sample.go", sampleSrc)
+
+	_, err := Generate(Options{
+		Files:         []string{file},
+		StructName:    "DoesNotExist",
+		PkgName:       "sample",
+		InterfaceName: "X",
+	})
+	if err == nil {
+		t.Fatal("expected error for missing struct, got nil")
+	}
+}
+
+const otherPkgSrc = `package sample
+
+import (
+	"fmt"
+	"time"
+)
+
+type Event struct {
+	At time.Time
+}
+
+func (e *Event) String() string {
+	return fmt.Sprintf("%v", e.At)
+}
+
+func (e *Event) Time() time.Time {
+	return e.At
+}
+`
+
+func TestGenerateImportsExternalPackage(t *testing.T) {
+	dir := t.TempDir()
+	file := writeTempFile(t, dir, "event.go", otherPkgSrc)
+
+	src, err := Generate(Options{
+		Files:         []string{file},
+		StructName:    "Event",
+		PkgName:       "sample",
+		InterfaceName: "Eventer",
+		RecvPointer:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := string(src)
+	if !strings.Contains(out, `"time"`) {
+		t.Errorf("expected generated file to import \"time\", got:\n%s", out)
+	}
+	if !strings.Contains(out, "time.Time") {
+		t.Errorf("expected time.Time to stay qualified, got:\n%s", out)
+	}
+}
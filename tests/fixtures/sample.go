@@ -1,13 +1,20 @@
 package sample
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/elev8tion/c-ext/tests/fixtures/format"
+	"github.com/elev8tion/c-ext/tests/fixtures/validate"
+)
 
 type UserService struct {
-	Name  string
-	Email string
-	Age   int
+	Name  string `json:"name" validate:"required,min=3" msg:"name_required"`
+	Email string `json:"email" validate:"required,email" msg:"email_invalid"`
+	Age   int    `json:"age" validate:"min=0,max=150" msg:"age_invalid"`
 }
 
+// Validator is implemented by types that can validate themselves. See
+// errors.go for the typed errors UserService.Validate returns.
 type Validator interface {
 	Validate() error
 	IsValid() bool
@@ -20,10 +27,77 @@ func NewUserService(name, email string) *UserService {
 	}
 }
 
+// NewUserServiceValidated is the opt-in constructor variant for callers that
+// want construction to fail fast on invalid input. It delegates to Validate
+// and reports every failure via the aggregate Errors type.
+func NewUserServiceValidated(name, email string, age int) (*UserService, error) {
+	u := &UserService{Name: name, Email: email, Age: age}
+	if err := u.Validate(); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
 func (u *UserService) String() string {
 	return fmt.Sprintf("%s <%s>", u.Name, u.Email)
 }
 
+// FormatOptions controls how StringWith presents a UserService.
+type FormatOptions struct {
+	// Locale selects the age phrasing via format.FormatAge, e.g. "en-US" or
+	// "id-ID". Empty falls back to format's default (en-US).
+	Locale string
+	// ShowAge appends the locale-formatted age when true.
+	ShowAge bool
+}
+
+// StringWith is String with optional locale-aware age presentation, via
+// the format package's FormatAge.
+func (u *UserService) StringWith(opts FormatOptions) string {
+	s := u.String()
+	if opts.ShowAge {
+		s = fmt.Sprintf("%s (%s)", s, format.FormatAge(u.Age, opts.Locale))
+	}
+	return s
+}
+
+const (
+	minAge = 0
+	maxAge = 150
+)
+
+// Validate runs the struct's `validate` tags through validate.ValidateStruct
+// and translates each failing field into the typed errors defined in
+// errors.go. Multiple failures are returned together as Errors, which still
+// supports errors.As on any individual cause.
+func (u *UserService) Validate() error {
+	fields := validate.ValidateStruct(u, nil)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	var errs Errors
+	if _, failed := fields["name"]; failed {
+		errs = append(errs, &ErrInvalidName{Name: u.Name})
+	}
+	if _, failed := fields["email"]; failed {
+		errs = append(errs, &ErrInvalidEmail{Email: u.Email})
+	}
+	if _, failed := fields["age"]; failed {
+		if u.Age < minAge {
+			errs = append(errs, &ErrUnderAge{Age: u.Age, Min: minAge})
+		} else {
+			errs = append(errs, &ErrOverAge{Age: u.Age, Max: maxAge})
+		}
+	}
+	return errs
+}
+
+// IsValid reports whether Validate would return no error.
+func (u *UserService) IsValid() bool {
+	return u.Validate() == nil
+}
+
 func FormatAge(age int) string {
 	return fmt.Sprintf("%d years old", age)
 }
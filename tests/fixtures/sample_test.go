@@ -0,0 +1,85 @@
+package sample
+
+import "testing"
+
+func TestUserServiceValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		user    UserService
+		wantErr bool
+		check   func(err error) bool
+	}{
+		{
+			name: "valid",
+			user: UserService{Name: "Alice", Email: "alice@example.com", Age: 30},
+		},
+		{
+			name:    "name too short",
+			user:    UserService{Name: "Al", Email: "alice@example.com", Age: 30},
+			wantErr: true,
+			check:   IsErrInvalidName,
+		},
+		{
+			name:    "invalid email",
+			user:    UserService{Name: "Alice", Email: "not-an-email", Age: 30},
+			wantErr: true,
+			check:   IsErrInvalidEmail,
+		},
+		{
+			name:    "under age",
+			user:    UserService{Name: "Alice", Email: "alice@example.com", Age: -1},
+			wantErr: true,
+			check:   IsErrUnderAge,
+		},
+		{
+			name:    "over age",
+			user:    UserService{Name: "Alice", Email: "alice@example.com", Age: 99999},
+			wantErr: true,
+			check:   IsErrOverAge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.user.Validate()
+			if tt.wantErr && err == nil {
+				t.Fatal("Validate() = nil, want error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("Validate() = %v, want nil", err)
+			}
+			if tt.check != nil && !tt.check(err) {
+				t.Errorf("Validate() = %v, failed expected check", err)
+			}
+			if got := tt.user.IsValid(); got != !tt.wantErr {
+				t.Errorf("IsValid() = %v, want %v", got, !tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewUserServiceValidated(t *testing.T) {
+	if _, err := NewUserServiceValidated("Alice", "alice@example.com", 30); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := NewUserServiceValidated("Alice", "alice@example.com", 99999); err == nil {
+		t.Fatal("expected error for age 99999, got nil")
+	} else if !IsErrOverAge(err) {
+		t.Fatalf("expected ErrOverAge, got %v", err)
+	}
+}
+
+func TestStringWith(t *testing.T) {
+	u := NewUserService("Alice", "alice@example.com")
+	u.Age = 3
+
+	if got := u.StringWith(FormatOptions{}); got != u.String() {
+		t.Errorf("StringWith({}) = %q, want %q", got, u.String())
+	}
+
+	got := u.StringWith(FormatOptions{ShowAge: true, Locale: "id-ID"})
+	want := u.String() + " (3 tahun)"
+	if got != want {
+		t.Errorf("StringWith(ShowAge) = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,177 @@
+// Package validate provides struct-tag-driven validation for the sample
+// package and any caller-defined type, as an alternative to hand-written
+// Validate() methods.
+package validate
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// RuleFunc checks whether a single field value satisfies a rule. arg is the
+// text following "=" in the tag (e.g. "3" in "min=3"), or empty when the
+// rule takes no argument.
+type RuleFunc func(v reflect.Value, arg string) bool
+
+var rules = map[string]RuleFunc{
+	"required": required,
+	"email":    email,
+	"min":      min,
+	"max":      max,
+	"regex":    matchRegex,
+}
+
+// RegisterRule adds or overrides a named validation rule so downstream code
+// can extend ValidateStruct without modifying this package.
+func RegisterRule(name string, fn RuleFunc) {
+	rules[name] = fn
+}
+
+var emailPattern = regexp.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`)
+
+// ValidateStruct reflects over the exported fields of v, which must be a
+// struct or a pointer to one, and evaluates the rules named in each field's
+// `validate` tag. Rules are comma-separated, e.g. `validate:"required,email"`
+// or `validate:"min=3"`.
+//
+// The returned map is keyed by the field's JSON tag name (falling back to
+// the Go field name when no `json` tag is present) and holds the message
+// for the first rule that field fails. messages overrides the default
+// message for a given `msg:"..."` key; a nil map uses the built-in
+// defaults.
+func ValidateStruct(v interface{}, messages map[string]string) map[string]string {
+	val := reflect.ValueOf(v)
+	if val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return nil
+	}
+
+	errs := map[string]string{}
+	typ := val.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue // unexported
+		}
+
+		tag, ok := field.Tag.Lookup("validate")
+		if !ok || tag == "" {
+			continue
+		}
+
+		jsonName := field.Tag.Get("json")
+		if jsonName == "" {
+			jsonName = field.Name
+		} else if idx := strings.Index(jsonName, ","); idx >= 0 {
+			jsonName = jsonName[:idx]
+		}
+
+		if msg, failed := firstFailure(val.Field(i), tag, field.Tag.Get("msg"), messages); failed {
+			errs[jsonName] = msg
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func firstFailure(fv reflect.Value, tag, msgKey string, messages map[string]string) (string, bool) {
+	for _, clause := range strings.Split(tag, ",") {
+		name, arg := clause, ""
+		if idx := strings.Index(clause, "="); idx >= 0 {
+			name, arg = clause[:idx], clause[idx+1:]
+		}
+
+		rule, ok := rules[name]
+		if !ok || rule(fv, arg) {
+			continue
+		}
+
+		if messages != nil {
+			if msg, ok := messages[msgKey]; ok {
+				return msg, true
+			}
+		}
+		return defaultMessage(name, arg), true
+	}
+	return "", false
+}
+
+func defaultMessage(rule, arg string) string {
+	switch rule {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be a valid email address"
+	case "min":
+		return "must be at least " + arg
+	case "max":
+		return "must be at most " + arg
+	case "regex":
+		return "does not match the required format"
+	default:
+		return "is invalid"
+	}
+}
+
+func required(v reflect.Value, _ string) bool {
+	return !v.IsZero()
+}
+
+func email(v reflect.Value, _ string) bool {
+	if v.Kind() != reflect.String {
+		return false
+	}
+	s := v.String()
+	if s == "" {
+		return true // required handles emptiness
+	}
+	return emailPattern.MatchString(s)
+}
+
+func min(v reflect.Value, arg string) bool {
+	n, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return true
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return int64(len(v.String())) >= n
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() >= n
+	default:
+		return true
+	}
+}
+
+func max(v reflect.Value, arg string) bool {
+	n, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return true
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return int64(len(v.String())) <= n
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() <= n
+	default:
+		return true
+	}
+}
+
+func matchRegex(v reflect.Value, arg string) bool {
+	if v.Kind() != reflect.String {
+		return false
+	}
+	re, err := regexp.Compile(arg)
+	if err != nil {
+		return true
+	}
+	return re.MatchString(v.String())
+}
@@ -0,0 +1,101 @@
+package validate
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testUser struct {
+	Name  string `json:"name" validate:"required,min=3"`
+	Email string `json:"email" validate:"required,email"`
+	Age   int    `json:"age" validate:"min=0,max=150"`
+	Bio   string `json:"bio" validate:"regex=^[a-z]*$"`
+}
+
+func TestValidateStruct(t *testing.T) {
+	tests := []struct {
+		name   string
+		user   testUser
+		fields []string // expected failing fields
+	}{
+		{
+			name:   "valid",
+			user:   testUser{Name: "Alice", Email: "alice@example.com", Age: 30, Bio: "hi"},
+			fields: nil,
+		},
+		{
+			name:   "name too short",
+			user:   testUser{Name: "Al", Email: "alice@example.com", Age: 30},
+			fields: []string{"name"},
+		},
+		{
+			name:   "missing name and bad email",
+			user:   testUser{Email: "not-an-email", Age: 30},
+			fields: []string{"name", "email"},
+		},
+		{
+			name:   "age over max",
+			user:   testUser{Name: "Alice", Email: "alice@example.com", Age: 200},
+			fields: []string{"age"},
+		},
+		{
+			name:   "age under min",
+			user:   testUser{Name: "Alice", Email: "alice@example.com", Age: -1},
+			fields: []string{"age"},
+		},
+		{
+			name:   "bio fails regex",
+			user:   testUser{Name: "Alice", Email: "alice@example.com", Age: 30, Bio: "Hi!"},
+			fields: []string{"bio"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateStruct(&tt.user, nil)
+			var got []string
+			for field := range errs {
+				got = append(got, field)
+			}
+			if len(got) != len(tt.fields) {
+				t.Fatalf("ValidateStruct() fields = %v, want %v", got, tt.fields)
+			}
+			for _, field := range tt.fields {
+				if _, ok := errs[field]; !ok {
+					t.Errorf("expected %q to fail, errs = %v", field, errs)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateStructCustomMessage(t *testing.T) {
+	type user struct {
+		Name string `json:"name" validate:"required" msg:"name_required"`
+	}
+	errs := ValidateStruct(&user{}, map[string]string{"name_required": "name cannot be blank"})
+	if errs["name"] != "name cannot be blank" {
+		t.Fatalf("errs[name] = %q, want custom message", errs["name"])
+	}
+}
+
+func TestValidateStructNonStruct(t *testing.T) {
+	if errs := ValidateStruct(42, nil); errs != nil {
+		t.Fatalf("ValidateStruct(42) = %v, want nil", errs)
+	}
+}
+
+func TestRegisterRule(t *testing.T) {
+	RegisterRule("even", func(v reflect.Value, _ string) bool {
+		return v.Kind() == reflect.Int && v.Int()%2 == 0
+	})
+	type user struct {
+		Count int `json:"count" validate:"even"`
+	}
+	if errs := ValidateStruct(&user{Count: 3}, nil); errs["count"] == "" {
+		t.Fatal("expected custom 'even' rule to reject odd count")
+	}
+	if errs := ValidateStruct(&user{Count: 4}, nil); errs != nil {
+		t.Fatalf("expected custom 'even' rule to accept even count, got %v", errs)
+	}
+}